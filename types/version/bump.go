@@ -0,0 +1,95 @@
+package version
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// IncMajor returns a new Version with Major incremented and Minor, Patch,
+// PreRelease, and Build reset, per the SemVer rule that any MAJOR change
+// resets everything below it.
+func (v Version) IncMajor() Version {
+	return Version{Major: v.Major + 1}
+}
+
+// IncMinor returns a new Version with Minor incremented and Patch,
+// PreRelease, and Build reset, per the SemVer rule that any MINOR change
+// resets everything below it.
+func (v Version) IncMinor() Version {
+	return Version{Major: v.Major, Minor: v.Minor + 1}
+}
+
+// IncPatch returns a new Version with Patch incremented and PreRelease
+// and Build reset.
+func (v Version) IncPatch() Version {
+	return Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch + 1}
+}
+
+// WithPreRelease returns a copy of v with its PreRelease field set to
+// preRelease.
+//
+// preRelease must conform to the SemVer pre-release grammar: dot-separated
+// identifiers from [0-9A-Za-z-], none empty, with numeric identifiers
+// carrying no leading zero. Returns ErrInvalidFormat otherwise.
+func (v Version) WithPreRelease(preRelease string) (Version, error) {
+	if err := validateIdentifiers(preRelease, true); err != nil {
+		return Version{}, err
+	}
+
+	v.PreRelease = preRelease
+	v.PreReleaseIDs = parsePreReleaseIDs(preRelease)
+
+	return v, nil
+}
+
+// WithBuild returns a copy of v with its Build field set to build.
+//
+// build must conform to the SemVer build-metadata grammar: dot-separated
+// identifiers from [0-9A-Za-z-], none empty. Unlike pre-release
+// identifiers, leading zeros are allowed. Returns ErrInvalidFormat
+// otherwise.
+func (v Version) WithBuild(build string) (Version, error) {
+	if err := validateIdentifiers(build, false); err != nil {
+		return Version{}, err
+	}
+
+	v.Build = build
+	v.BuildIDs = parseBuildIDs(build)
+
+	return v, nil
+}
+
+// validateIdentifiers checks s against the dot-separated identifier
+// grammar shared by pre-release and build metadata. When rejectLeadingZero
+// is true, all-digit identifiers longer than one character may not start
+// with '0' (the pre-release rule; build metadata has no such rule).
+func validateIdentifiers(s string, rejectLeadingZero bool) error {
+	if s == "" {
+		return errors.Join(ErrInvalidFormat, errors.New("identifier must not be empty"))
+	}
+
+	for _, id := range strings.Split(s, ".") {
+		if id == "" {
+			return errors.Join(ErrInvalidFormat, fmt.Errorf("empty identifier in %q", s))
+		}
+
+		numeric := true
+
+		for _, r := range id {
+			switch {
+			case r >= '0' && r <= '9':
+			case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r == '-':
+				numeric = false
+			default:
+				return errors.Join(ErrInvalidFormat, fmt.Errorf("invalid character %q in identifier %q", r, id))
+			}
+		}
+
+		if rejectLeadingZero && numeric && len(id) > 1 && id[0] == '0' {
+			return errors.Join(ErrInvalidFormat, fmt.Errorf("numeric identifier %q has a leading zero", id))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package version_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Diaszano/gotterns/types/version"
+)
+
+func TestVersion_IncMajor(t *testing.T) {
+	v := version.Version{Major: 1, Minor: 2, Patch: 3, PreRelease: "beta", Build: "001"}
+
+	require.Equal(t, version.Version{Major: 2}, v.IncMajor())
+}
+
+func TestVersion_IncMinor(t *testing.T) {
+	v := version.Version{Major: 1, Minor: 2, Patch: 3, PreRelease: "beta", Build: "001"}
+
+	require.Equal(t, version.Version{Major: 1, Minor: 3}, v.IncMinor())
+}
+
+func TestVersion_IncPatch(t *testing.T) {
+	v := version.Version{Major: 1, Minor: 2, Patch: 3, PreRelease: "beta", Build: "001"}
+
+	require.Equal(t, version.Version{Major: 1, Minor: 2, Patch: 4}, v.IncPatch())
+}
+
+func TestVersion_WithPreRelease(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{name: "simple identifier", input: "alpha"},
+		{name: "dotted identifiers", input: "alpha.1"},
+		{name: "zero is not a leading zero", input: "0"},
+		{name: "empty string is invalid", input: "", wantErr: true},
+		{name: "empty identifier is invalid", input: "alpha..1", wantErr: true},
+		{name: "leading zero on numeric identifier is invalid", input: "alpha.01", wantErr: true},
+		{name: "invalid character is invalid", input: "alpha_1", wantErr: true},
+	}
+
+	base := version.Version{Major: 1, Minor: 0, Patch: 0}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := base.WithPreRelease(tt.input)
+
+			if tt.wantErr {
+				require.ErrorIs(t, err, version.ErrInvalidFormat)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.input, got.PreRelease)
+		})
+	}
+}
+
+func TestVersion_WithBuild(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{name: "simple identifier", input: "build"},
+		{name: "leading zero is allowed", input: "001"},
+		{name: "empty string is invalid", input: "", wantErr: true},
+		{name: "empty identifier is invalid", input: "build..1", wantErr: true},
+		{name: "invalid character is invalid", input: "build_1", wantErr: true},
+	}
+
+	base := version.Version{Major: 1, Minor: 0, Patch: 0}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := base.WithBuild(tt.input)
+
+			if tt.wantErr {
+				require.ErrorIs(t, err, version.ErrInvalidFormat)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.input, got.Build)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package version
+
+import (
+	"strconv"
+	"strings"
+)
+
+// PreReleaseID is a single dot-separated identifier of a pre-release
+// string, as produced by TryParse and stored in Version.PreReleaseIDs.
+//
+// IsNum reports whether the identifier is all-digits, per SemVer's
+// numeric-identifier rule; in that case Num holds its value. Otherwise
+// Str holds the identifier verbatim.
+type PreReleaseID struct {
+	Num   uint64
+	Str   string
+	IsNum bool
+}
+
+// String returns the identifier in its original dotted-segment form.
+func (id PreReleaseID) String() string {
+	if id.IsNum {
+		return strconv.FormatUint(id.Num, 10)
+	}
+
+	return id.Str
+}
+
+// parsePreReleaseIDs splits a pre-release string into its identifiers,
+// classifying each as numeric or alphanumeric. Returns nil for an empty
+// input.
+func parsePreReleaseIDs(preRelease string) []PreReleaseID {
+	if preRelease == "" {
+		return nil
+	}
+
+	ids := make([]PreReleaseID, strings.Count(preRelease, ".")+1)
+
+	for i, rest := 0, preRelease; i < len(ids); i++ {
+		var seg string
+		seg, rest, _ = strings.Cut(rest, ".")
+
+		ids[i] = PreReleaseID{Str: seg}
+		if isAllDigits(seg) {
+			if n, err := strconv.ParseUint(seg, 10, 64); err == nil {
+				ids[i] = PreReleaseID{Num: n, Str: seg, IsNum: true}
+			}
+		}
+	}
+
+	return ids
+}
+
+// parseBuildIDs splits a build-metadata string into its dot-separated
+// identifiers. Returns nil for an empty input.
+func parseBuildIDs(build string) []string {
+	if build == "" {
+		return nil
+	}
+
+	return strings.Split(build, ".")
+}
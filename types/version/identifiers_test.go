@@ -0,0 +1,45 @@
+package version_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Diaszano/gotterns/types/version"
+)
+
+func TestPreReleaseID_String(t *testing.T) {
+	tests := []struct {
+		name string
+		id   version.PreReleaseID
+		want string
+	}{
+		{name: "numeric identifier", id: version.PreReleaseID{Num: 11, Str: "11", IsNum: true}, want: "11"},
+		{name: "alphanumeric identifier", id: version.PreReleaseID{Str: "alpha"}, want: "alpha"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.id.String())
+		})
+	}
+}
+
+func TestTryParse_PopulatesIdentifierSlices(t *testing.T) {
+	v, err := version.TryParse("1.2.3-alpha.11+build.001")
+	require.NoError(t, err)
+
+	require.Equal(t, []version.PreReleaseID{
+		{Str: "alpha"},
+		{Num: 11, Str: "11", IsNum: true},
+	}, v.PreReleaseIDs)
+	require.Equal(t, []string{"build", "001"}, v.BuildIDs)
+}
+
+func TestTryParse_NoPreReleaseOrBuildLeavesSlicesNil(t *testing.T) {
+	v, err := version.TryParse("1.2.3")
+	require.NoError(t, err)
+
+	require.Nil(t, v.PreReleaseIDs)
+	require.Nil(t, v.BuildIDs)
+}
@@ -0,0 +1,100 @@
+package version
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// VersionStruct is Version with its default struct-based JSON
+// representation ({"major":1,"minor":2,"patch":3,...}), kept for
+// consumers that depend on that shape. Version itself now marshals to
+// its canonical string form; use MarshalJSONStruct to get the old
+// behavior.
+type VersionStruct Version
+
+// MarshalJSON encodes v as its canonical SemVer string, e.g.
+// "1.2.3-beta+build".
+func (v Version) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.String())
+}
+
+// UnmarshalJSON decodes a canonical SemVer string into v.
+func (v *Version) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	parsed, err := TryParse(s)
+	if err != nil {
+		return err
+	}
+
+	*v = parsed
+
+	return nil
+}
+
+// MarshalJSONStruct encodes v using its original struct representation,
+// for consumers that need the pre-existing {"major":...} shape instead
+// of the canonical string form used by MarshalJSON.
+func (v Version) MarshalJSONStruct() ([]byte, error) {
+	return json.Marshal(VersionStruct(v))
+}
+
+// MarshalText encodes v as its canonical SemVer string, satisfying
+// encoding.TextMarshaler. This lets Version be used as a map key and in
+// any format (YAML, TOML, ...) that falls back to the text codec.
+func (v Version) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText decodes a canonical SemVer string into v, satisfying
+// encoding.TextUnmarshaler.
+func (v *Version) UnmarshalText(text []byte) error {
+	parsed, err := TryParse(string(text))
+	if err != nil {
+		return err
+	}
+
+	*v = parsed
+
+	return nil
+}
+
+// Value encodes v as its canonical SemVer string, satisfying
+// database/sql/driver.Valuer so Version can be written to a SQL column.
+func (v Version) Value() (driver.Value, error) {
+	return v.String(), nil
+}
+
+// Scan decodes a SemVer string from a database column into v, satisfying
+// database/sql.Scanner. A nil source resets v to its zero value.
+func (v *Version) Scan(src any) error {
+	switch s := src.(type) {
+	case nil:
+		*v = Version{}
+		return nil
+	case string:
+		parsed, err := TryParse(s)
+		if err != nil {
+			return err
+		}
+
+		*v = parsed
+
+		return nil
+	case []byte:
+		parsed, err := TryParse(string(s))
+		if err != nil {
+			return err
+		}
+
+		*v = parsed
+
+		return nil
+	default:
+		return fmt.Errorf("%w: cannot scan %T into Version", ErrInvalidFormat, src)
+	}
+}
@@ -0,0 +1,83 @@
+package version_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Diaszano/gotterns/types/version"
+)
+
+func TestVersion_MarshalJSON(t *testing.T) {
+	v := version.Version{Major: 1, Minor: 2, Patch: 3, PreRelease: "beta", Build: "001"}
+
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	require.Equal(t, `"1.2.3-beta+001"`, string(data))
+}
+
+func TestVersion_UnmarshalJSON(t *testing.T) {
+	var v version.Version
+
+	err := json.Unmarshal([]byte(`"1.2.3-beta+001"`), &v)
+	require.NoError(t, err)
+	require.Equal(t, version.Version{
+		Major: 1, Minor: 2, Patch: 3,
+		PreRelease:    "beta",
+		Build:         "001",
+		PreReleaseIDs: []version.PreReleaseID{{Str: "beta"}},
+		BuildIDs:      []string{"001"},
+	}, v)
+
+	err = json.Unmarshal([]byte(`"not-a-version"`), &v)
+	require.ErrorIs(t, err, version.ErrInvalidFormat)
+}
+
+func TestVersion_MarshalJSONStruct(t *testing.T) {
+	v := version.Version{Major: 1, Minor: 2, Patch: 3, PreRelease: "beta"}
+
+	data, err := v.MarshalJSONStruct()
+	require.NoError(t, err)
+	require.JSONEq(t, `{"major":1,"minor":2,"patch":3,"pre_release":"beta"}`, string(data))
+}
+
+func TestVersion_TextMarshaling(t *testing.T) {
+	v := version.Version{
+		Major: 1, Minor: 2, Patch: 3,
+		PreRelease:    "beta",
+		PreReleaseIDs: []version.PreReleaseID{{Str: "beta"}},
+	}
+
+	text, err := v.MarshalText()
+	require.NoError(t, err)
+	require.Equal(t, "1.2.3-beta", string(text))
+
+	var got version.Version
+	require.NoError(t, got.UnmarshalText(text))
+	require.Equal(t, v, got)
+}
+
+func TestVersion_SQLValueAndScan(t *testing.T) {
+	v := version.Version{
+		Major: 1, Minor: 2, Patch: 3,
+		PreRelease:    "beta",
+		PreReleaseIDs: []version.PreReleaseID{{Str: "beta"}},
+	}
+
+	value, err := v.Value()
+	require.NoError(t, err)
+	require.Equal(t, "1.2.3-beta", value)
+
+	var got version.Version
+	require.NoError(t, got.Scan("1.2.3-beta"))
+	require.Equal(t, v, got)
+
+	require.NoError(t, got.Scan([]byte("2.0.0")))
+	require.Equal(t, version.Version{Major: 2}, got)
+
+	require.NoError(t, got.Scan(nil))
+	require.Equal(t, version.Version{}, got)
+
+	require.Error(t, got.Scan(42))
+}
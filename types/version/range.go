@@ -0,0 +1,447 @@
+package version
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Range is a version constraint, as produced by ParseRange.
+//
+// A Range is internally represented as a disjunction ("OR", joined with
+// "||") of comparator sets, where each set is a conjunction ("AND",
+// joined by whitespace) of comparators. A Version satisfies the Range if
+// it satisfies at least one of the comparator sets.
+type Range struct {
+	raw  string
+	sets []rangeSet
+}
+
+// rangeSet is a conjunction of comparators that must all match. raw is
+// this set's own AND-clause text (e.g. ">=1.2.0 <2.0.0"), tracked
+// separately from the other sets in a Range so that OR precedence is
+// preserved when sets are combined (see Range.AND).
+type rangeSet struct {
+	comparators []comparator
+	raw         string
+}
+
+// comparator is a single operator/version pair, e.g. ">=1.2.0".
+type comparator struct {
+	op string
+	v  Version
+}
+
+// Matches reports whether v satisfies the range.
+//
+// Per SemVer 2.0.0's usual "no surprise pre-releases" rule, a pre-release
+// version only satisfies a comparator set when that set explicitly names
+// a pre-release on the same MAJOR.MINOR.PATCH.
+func (r Range) Matches(v Version) bool {
+	for _, set := range r.sets {
+		if set.matches(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String returns the range in range syntax (each comparator set's clause
+// text, joined with " || "), so that ParseRange(r.String()) reconstructs
+// an equivalent Range.
+func (r Range) String() string {
+	return r.raw
+}
+
+// AND combines r and other into a Range that matches only versions
+// satisfied by both, by taking the cross product of their comparator
+// sets. Because the range grammar has no parentheses, the cross product
+// is also distributed into each resulting set's own raw text, so that an
+// OR on either side keeps its original precedence in Range.String().
+func (r Range) AND(other Range) Range {
+	sets := make([]rangeSet, 0, len(r.sets)*len(other.sets))
+	for _, a := range r.sets {
+		for _, b := range other.sets {
+			comparators := make([]comparator, 0, len(a.comparators)+len(b.comparators))
+			comparators = append(comparators, a.comparators...)
+			comparators = append(comparators, b.comparators...)
+			sets = append(sets, rangeSet{comparators: comparators, raw: a.raw + " " + b.raw})
+		}
+	}
+
+	return Range{raw: joinSetsRaw(sets), sets: sets}
+}
+
+// OR combines r and other into a Range that matches any version
+// satisfied by either one.
+func (r Range) OR(other Range) Range {
+	sets := make([]rangeSet, 0, len(r.sets)+len(other.sets))
+	sets = append(sets, r.sets...)
+	sets = append(sets, other.sets...)
+
+	return Range{raw: joinSetsRaw(sets), sets: sets}
+}
+
+// joinSetsRaw renders sets back into "||"-joined range syntax, each set
+// contributing its own AND-clause text.
+func joinSetsRaw(sets []rangeSet) string {
+	raws := make([]string, len(sets))
+	for i, set := range sets {
+		raws[i] = set.raw
+	}
+
+	return strings.Join(raws, " || ")
+}
+
+// matches reports whether v satisfies every comparator in the set,
+// applying the pre-release visibility rule across the whole set.
+func (rs rangeSet) matches(v Version) bool {
+	if v.PreRelease != "" && !rs.allowsPreRelease(v) {
+		return false
+	}
+
+	for _, c := range rs.comparators {
+		if !c.matches(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// allowsPreRelease reports whether some comparator in the set names a
+// pre-release on the same MAJOR.MINOR.PATCH as v, which is required
+// before a pre-release version can satisfy the set at all.
+func (rs rangeSet) allowsPreRelease(v Version) bool {
+	for _, c := range rs.comparators {
+		if c.v.PreRelease != "" &&
+			c.v.Major == v.Major && c.v.Minor == v.Minor && c.v.Patch == v.Patch {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matches applies the comparator's operator, ignoring pre-release
+// visibility (handled at the rangeSet level).
+func (c comparator) matches(v Version) bool {
+	cmp := v.Compare(c.v)
+
+	switch c.op {
+	case "=":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	default:
+		return false
+	}
+}
+
+// ParseRange parses a version constraint string, modeled on the
+// npm/blang/semver range syntax.
+//
+// Supported syntax:
+//   - comparators: =, !=, <, <=, >, >=
+//   - AND via whitespace: ">=1.2.0 <2.0.0"
+//   - OR via "||": "1.x || >=2.2.0 <3.0.0"
+//   - caret ranges: "^1.2.3" (compatible with 1.2.3, respecting the 0.x
+//     and 0.0.x caret rules)
+//   - tilde ranges: "~1.2.3" (>=1.2.3 <1.3.0)
+//   - hyphen ranges: "1.2.3 - 2.3.4"
+//   - wildcards: "1.2.x", "1.x", "*"
+//
+// Returns ErrEmpty if s is blank, and ErrInvalidFormat if s does not
+// conform to this grammar.
+func ParseRange(s string) (Range, error) {
+	raw := strings.TrimSpace(s)
+	if raw == "" {
+		return Range{}, ErrEmpty
+	}
+
+	clauses := strings.Split(raw, "||")
+	sets := make([]rangeSet, 0, len(clauses))
+
+	for _, clause := range clauses {
+		clause = strings.TrimSpace(clause)
+		if clause == "" {
+			return Range{}, errors.Join(ErrInvalidFormat, fmt.Errorf("empty clause in range %q", raw))
+		}
+
+		set, err := parseRangeSet(clause)
+		if err != nil {
+			return Range{}, err
+		}
+
+		sets = append(sets, set)
+	}
+
+	return Range{raw: joinSetsRaw(sets), sets: sets}, nil
+}
+
+// parseRangeSet parses a single AND-joined comparator set, such as
+// ">=1.2.0 <2.0.0" or "1.2.3 - 2.3.4".
+func parseRangeSet(clause string) (rangeSet, error) {
+	tokens := strings.Fields(clause)
+
+	var comparators []comparator
+
+	for i := 0; i < len(tokens); i++ {
+		if i+2 < len(tokens) && tokens[i+1] == "-" {
+			cs, err := parseHyphenRange(tokens[i], tokens[i+2])
+			if err != nil {
+				return rangeSet{}, err
+			}
+
+			comparators = append(comparators, cs...)
+			i += 2
+			continue
+		}
+
+		cs, err := parseComparatorToken(tokens[i])
+		if err != nil {
+			return rangeSet{}, err
+		}
+
+		comparators = append(comparators, cs...)
+	}
+
+	if len(comparators) == 0 {
+		return rangeSet{}, errors.Join(ErrInvalidFormat, fmt.Errorf("no comparators in clause %q", clause))
+	}
+
+	return rangeSet{comparators: comparators, raw: clause}, nil
+}
+
+// parseComparatorToken parses a single token of a comparator set, such as
+// ">=1.2.3", "^1.2", "~1", or a bare wildcard like "1.2.x".
+func parseComparatorToken(tok string) ([]comparator, error) {
+	op, rest := splitOperator(tok)
+
+	switch op {
+	case "^":
+		return caretRange(rest)
+	case "~":
+		return tildeRange(rest)
+	case "":
+		return wildcardRange(rest)
+	default:
+		maj, min, pat, _, pre, err := parseNumeric(rest)
+		if err != nil {
+			return nil, err
+		}
+
+		return []comparator{{op: op, v: Version{Major: maj, Minor: min, Patch: pat, PreRelease: pre}}}, nil
+	}
+}
+
+// splitOperator splits a leading comparator operator (or caret/tilde
+// sigil) off of tok, returning ("", tok) if none is present.
+func splitOperator(tok string) (op, rest string) {
+	switch {
+	case strings.HasPrefix(tok, ">="):
+		return ">=", tok[2:]
+	case strings.HasPrefix(tok, "<="):
+		return "<=", tok[2:]
+	case strings.HasPrefix(tok, "=="):
+		return "=", tok[2:]
+	case strings.HasPrefix(tok, "!="):
+		return "!=", tok[2:]
+	case strings.HasPrefix(tok, ">"):
+		return ">", tok[1:]
+	case strings.HasPrefix(tok, "<"):
+		return "<", tok[1:]
+	case strings.HasPrefix(tok, "="):
+		return "=", tok[1:]
+	case strings.HasPrefix(tok, "^"):
+		return "^", tok[1:]
+	case strings.HasPrefix(tok, "~"):
+		return "~", tok[1:]
+	default:
+		return "", tok
+	}
+}
+
+// wildcardRange expands a bare, possibly partial version into the
+// comparators it implies: "*" matches anything, "1" means >=1.0.0 <2.0.0,
+// "1.2" and "1.2.x" mean >=1.2.0 <1.3.0, and a fully specified version is
+// an exact match.
+func wildcardRange(rest string) ([]comparator, error) {
+	maj, min, _, parts, _, err := parseNumeric(rest)
+	if err != nil {
+		return nil, err
+	}
+
+	switch parts {
+	case 0:
+		return []comparator{{op: ">=", v: Version{}}}, nil
+	case 1:
+		return []comparator{
+			{op: ">=", v: Version{Major: maj}},
+			{op: "<", v: Version{Major: maj + 1}},
+		}, nil
+	case 2:
+		return []comparator{
+			{op: ">=", v: Version{Major: maj, Minor: min}},
+			{op: "<", v: Version{Major: maj, Minor: min + 1}},
+		}, nil
+	default:
+		v, err := TryParse(rest)
+		if err != nil {
+			return nil, err
+		}
+
+		return []comparator{{op: "=", v: v}}, nil
+	}
+}
+
+// caretRange expands "^<version>" into its >=/< comparator pair,
+// compatible with everything up to (but not including) the next change
+// that the SemVer spec considers breaking: the next major version for
+// MAJOR>0, the next minor for 0.MINOR>0, and the next patch for 0.0.x.
+func caretRange(rest string) ([]comparator, error) {
+	maj, min, pat, parts, pre, err := parseNumeric(rest)
+	if err != nil {
+		return nil, err
+	}
+
+	lo := Version{Major: maj, Minor: min, Patch: pat, PreRelease: pre}
+
+	var hi Version
+	switch {
+	case parts <= 1:
+		hi = Version{Major: maj + 1}
+	case maj > 0:
+		hi = Version{Major: maj + 1}
+	case min > 0:
+		hi = Version{Minor: min + 1}
+	case parts == 3:
+		// maj == 0 && min == 0 && a patch was explicitly given: 0.0.x.
+		hi = Version{Patch: pat + 1}
+	default:
+		// maj == 0 && min == 0 with no patch given (e.g. "^0.0"): treat
+		// like any other 0.x range and allow patch/minor-adjacent bumps
+		// up to the next minor, per the usual 0.0.x-requires-a-patch rule.
+		hi = Version{Minor: min + 1}
+	}
+
+	return []comparator{{op: ">=", v: lo}, {op: "<", v: hi}}, nil
+}
+
+// tildeRange expands "~<version>" into its >=/< comparator pair: patch-level
+// changes are allowed if a minor is specified, otherwise minor-level
+// changes are allowed.
+func tildeRange(rest string) ([]comparator, error) {
+	maj, min, pat, parts, pre, err := parseNumeric(rest)
+	if err != nil {
+		return nil, err
+	}
+
+	lo := Version{Major: maj, Minor: min, Patch: pat, PreRelease: pre}
+
+	var hi Version
+	if parts <= 1 {
+		hi = Version{Major: maj + 1}
+	} else {
+		hi = Version{Major: maj, Minor: min + 1}
+	}
+
+	return []comparator{{op: ">=", v: lo}, {op: "<", v: hi}}, nil
+}
+
+// parseHyphenRange expands "<lo> - <hi>" into an inclusive >=/<= (or >=/<)
+// comparator pair. A partial hi (e.g. "2.3" or "2") widens to the
+// exclusive upper bound implied by the missing precision; a wildcard hi
+// ("*" or "x") leaves the range with no upper bound.
+func parseHyphenRange(loTok, hiTok string) ([]comparator, error) {
+	loMaj, loMin, loPat, _, loPre, err := parseNumeric(loTok)
+	if err != nil {
+		return nil, err
+	}
+
+	hiMaj, hiMin, _, hiParts, _, err := parseNumeric(hiTok)
+	if err != nil {
+		return nil, err
+	}
+
+	lo := comparator{op: ">=", v: Version{Major: loMaj, Minor: loMin, Patch: loPat, PreRelease: loPre}}
+
+	var hi comparator
+	switch hiParts {
+	case 3:
+		v, err := TryParse(hiTok)
+		if err != nil {
+			return nil, err
+		}
+
+		hi = comparator{op: "<=", v: v}
+	case 2:
+		hi = comparator{op: "<", v: Version{Major: hiMaj, Minor: hiMin + 1}}
+	case 0:
+		return []comparator{lo}, nil
+	default:
+		hi = comparator{op: "<", v: Version{Major: hiMaj + 1}}
+	}
+
+	return []comparator{lo, hi}, nil
+}
+
+// parseNumeric parses the numeric MAJOR[.MINOR[.PATCH]] prefix of s,
+// stopping at the first wildcard segment ("x", "X", or "*"), along with
+// any pre-release suffix. parts reports how many concrete numeric
+// segments were found (0-3); missing segments are returned as zero.
+func parseNumeric(s string) (maj, min, pat uint64, parts int, preRelease string, err error) {
+	numericPart := s
+	if idx := strings.IndexByte(s, '-'); idx >= 0 {
+		numericPart = s[:idx]
+		preRelease = s[idx+1:]
+		if bIdx := strings.IndexByte(preRelease, '+'); bIdx >= 0 {
+			preRelease = preRelease[:bIdx]
+		}
+	} else if idx := strings.IndexByte(s, '+'); idx >= 0 {
+		numericPart = s[:idx]
+	}
+
+	if numericPart == "" || numericPart == "*" {
+		return 0, 0, 0, 0, preRelease, nil
+	}
+
+	segments := strings.Split(numericPart, ".")
+	values := make([]uint64, 0, 3)
+
+	for _, seg := range segments {
+		if seg == "x" || seg == "X" || seg == "*" {
+			break
+		}
+
+		n, convErr := strconv.ParseUint(seg, 10, 64)
+		if convErr != nil {
+			return 0, 0, 0, 0, "", errors.Join(ErrInvalidFormat, fmt.Errorf("invalid numeric segment %q in %q", seg, s))
+		}
+
+		values = append(values, n)
+	}
+
+	parts = len(values)
+	if parts > 0 {
+		maj = values[0]
+	}
+	if parts > 1 {
+		min = values[1]
+	}
+	if parts > 2 {
+		pat = values[2]
+	}
+
+	return maj, min, pat, parts, preRelease, nil
+}
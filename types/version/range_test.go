@@ -0,0 +1,140 @@
+package version_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Diaszano/gotterns/types/version"
+)
+
+func TestParseRange_Matches(t *testing.T) {
+	tests := []struct {
+		name    string
+		rng     string
+		version string
+		want    bool
+	}{
+		{name: "simple gte", rng: ">=1.2.0", version: "1.2.0", want: true},
+		{name: "simple gte below", rng: ">=1.2.0", version: "1.1.9", want: false},
+		{name: "and clause", rng: ">=1.2.0 <2.0.0", version: "1.5.0", want: true},
+		{name: "and clause excludes", rng: ">=1.2.0 <2.0.0", version: "2.0.0", want: false},
+		{name: "or clause left", rng: "1.x || >=2.2.0 <3.0.0", version: "1.9.9", want: true},
+		{name: "or clause right", rng: "1.x || >=2.2.0 <3.0.0", version: "2.5.0", want: true},
+		{name: "or clause neither", rng: "1.x || >=2.2.0 <3.0.0", version: "2.0.0", want: false},
+		{name: "caret within major", rng: "^1.2.3", version: "1.9.0", want: true},
+		{name: "caret crosses major", rng: "^1.2.3", version: "2.0.0", want: false},
+		{name: "caret below floor", rng: "^1.2.3", version: "1.2.2", want: false},
+		{name: "caret on 0.x locks minor", rng: "^0.2.3", version: "0.2.9", want: true},
+		{name: "caret on 0.x rejects next minor", rng: "^0.2.3", version: "0.3.0", want: false},
+		{name: "caret on 0.0.x locks patch", rng: "^0.0.3", version: "0.0.3", want: true},
+		{name: "caret on 0.0.x rejects next patch", rng: "^0.0.3", version: "0.0.4", want: false},
+		{name: "caret on 0.0 with no patch allows minor-local bumps", rng: "^0.0", version: "0.0.5", want: true},
+		{name: "caret on 0.0 with no patch rejects next minor", rng: "^0.0", version: "0.1.0", want: false},
+		{name: "tilde allows patch bumps", rng: "~1.2.3", version: "1.2.9", want: true},
+		{name: "tilde rejects minor bump", rng: "~1.2.3", version: "1.3.0", want: false},
+		{name: "hyphen range inclusive", rng: "1.2.3 - 2.3.4", version: "2.3.4", want: true},
+		{name: "hyphen range partial upper", rng: "1.2.3 - 2.3", version: "2.3.9", want: true},
+		{name: "hyphen range partial upper excludes", rng: "1.2.3 - 2.3", version: "2.4.0", want: false},
+		{name: "wildcard minor", rng: "1.2.x", version: "1.2.7", want: true},
+		{name: "wildcard minor excludes", rng: "1.2.x", version: "1.3.0", want: false},
+		{name: "wildcard major", rng: "1.x", version: "1.99.0", want: true},
+		{name: "star matches anything", rng: "*", version: "9.9.9", want: true},
+		{name: "pre-release hidden by default", rng: ">=1.2.0 <2.0.0", version: "1.5.0-alpha", want: false},
+		{name: "pre-release visible when named", rng: ">=1.5.0-alpha <2.0.0", version: "1.5.0-alpha", want: true},
+		{name: "pre-release on different tuple stays hidden", rng: ">=1.5.0-alpha <2.0.0", version: "1.6.0-beta", want: false},
+		{name: "not equal excludes", rng: "!=1.2.3", version: "1.2.3", want: false},
+		{name: "not equal includes others", rng: "!=1.2.3", version: "1.2.4", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := version.ParseRange(tt.rng)
+			require.NoError(t, err, "unexpected error parsing range %q", tt.rng)
+
+			v, err := version.TryParse(tt.version)
+			require.NoError(t, err, "unexpected error parsing version %q", tt.version)
+
+			require.Equal(t, tt.want, r.Matches(v))
+		})
+	}
+}
+
+func TestParseRange_InvalidFormat(t *testing.T) {
+	tests := []string{
+		"",
+		">=1.2.0 ||",
+		">=not-a-version",
+	}
+
+	for _, rng := range tests {
+		t.Run(rng, func(t *testing.T) {
+			_, err := version.ParseRange(rng)
+			require.Error(t, err, "expected error for range %q", rng)
+		})
+	}
+}
+
+func TestRange_String(t *testing.T) {
+	r, err := version.ParseRange(">=1.2.0 <2.0.0")
+	require.NoError(t, err)
+	require.Equal(t, ">=1.2.0 <2.0.0", r.String())
+}
+
+func TestRange_AND(t *testing.T) {
+	a, err := version.ParseRange(">=1.0.0")
+	require.NoError(t, err)
+	b, err := version.ParseRange("<2.0.0")
+	require.NoError(t, err)
+
+	combined := a.AND(b)
+
+	v1, _ := version.TryParse("1.5.0")
+	v2, _ := version.TryParse("2.5.0")
+
+	require.True(t, combined.Matches(v1))
+	require.False(t, combined.Matches(v2))
+}
+
+func TestRange_AND_DistributesOverOR(t *testing.T) {
+	a, err := version.ParseRange("<5.0.0 || >=10.0.0")
+	require.NoError(t, err)
+	b, err := version.ParseRange(">=2.0.0")
+	require.NoError(t, err)
+
+	combined := a.AND(b)
+
+	v1, _ := version.TryParse("1.0.0")
+
+	require.False(t, combined.Matches(v1))
+
+	roundTripped, err := version.ParseRange(combined.String())
+	require.NoError(t, err, "combined.String() must itself be a valid range")
+	require.Equal(t, combined.Matches(v1), roundTripped.Matches(v1),
+		"ParseRange(combined.String()) must reconstruct an equivalent Range")
+
+	v2, _ := version.TryParse("3.0.0")
+	require.True(t, combined.Matches(v2))
+	require.Equal(t, combined.Matches(v2), roundTripped.Matches(v2))
+
+	v3, _ := version.TryParse("12.0.0")
+	require.True(t, combined.Matches(v3))
+	require.Equal(t, combined.Matches(v3), roundTripped.Matches(v3))
+}
+
+func TestRange_OR(t *testing.T) {
+	a, err := version.ParseRange("1.x")
+	require.NoError(t, err)
+	b, err := version.ParseRange("3.x")
+	require.NoError(t, err)
+
+	combined := a.OR(b)
+
+	v1, _ := version.TryParse("1.2.0")
+	v2, _ := version.TryParse("3.2.0")
+	v3, _ := version.TryParse("2.2.0")
+
+	require.True(t, combined.Matches(v1))
+	require.True(t, combined.Matches(v2))
+	require.False(t, combined.Matches(v3))
+}
@@ -0,0 +1,160 @@
+package version
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// scanVersion performs a single left-to-right scan of s (already stripped
+// of any leading 'v') and parses it as a SemVer 2.0.0 string, without the
+// allocations or repeated matching of a regular expression.
+//
+// It returns the parsed numeric core plus the raw pre-release and build
+// substrings (still in their dotted form, for String() and the
+// PreReleaseIDs/BuildIDs parsers to consume).
+func scanVersion(s string) (major, minor, patch uint64, preRelease, build string, err error) {
+	pos := 0
+
+	major, pos, err = scanNumericSegment(s, pos)
+	if err != nil {
+		return 0, 0, 0, "", "", err
+	}
+
+	pos, err = expect(s, pos, '.')
+	if err != nil {
+		return 0, 0, 0, "", "", err
+	}
+
+	minor, pos, err = scanNumericSegment(s, pos)
+	if err != nil {
+		return 0, 0, 0, "", "", err
+	}
+
+	pos, err = expect(s, pos, '.')
+	if err != nil {
+		return 0, 0, 0, "", "", err
+	}
+
+	patch, pos, err = scanNumericSegment(s, pos)
+	if err != nil {
+		return 0, 0, 0, "", "", err
+	}
+
+	if pos < len(s) && s[pos] == '-' {
+		pos++
+
+		start := pos
+
+		pos, err = scanIdentifiers(s, pos, true)
+		if err != nil {
+			return 0, 0, 0, "", "", err
+		}
+
+		preRelease = s[start:pos]
+	}
+
+	if pos < len(s) && s[pos] == '+' {
+		pos++
+
+		start := pos
+
+		pos, err = scanIdentifiers(s, pos, false)
+		if err != nil {
+			return 0, 0, 0, "", "", err
+		}
+
+		build = s[start:pos]
+	}
+
+	if pos != len(s) {
+		return 0, 0, 0, "", "", fmt.Errorf("%w at offset %d: unexpected character %q", ErrInvalidFormat, pos, s[pos])
+	}
+
+	return major, minor, patch, preRelease, build, nil
+}
+
+// expect consumes the byte want at pos, returning an error with positional
+// context if it is not there.
+func expect(s string, pos int, want byte) (int, error) {
+	if pos >= len(s) || s[pos] != want {
+		return pos, fmt.Errorf("%w at offset %d: expected %q", ErrInvalidFormat, pos, want)
+	}
+
+	return pos + 1, nil
+}
+
+// scanNumericSegment scans a single MAJOR/MINOR/PATCH segment starting at
+// pos: one or more digits, with no leading zero unless the segment is
+// exactly "0". Returns the parsed value and the position just past it.
+func scanNumericSegment(s string, pos int) (uint64, int, error) {
+	start := pos
+
+	for pos < len(s) && isDigit(s[pos]) {
+		pos++
+	}
+
+	digits := s[start:pos]
+	if digits == "" {
+		return 0, pos, fmt.Errorf("%w at offset %d: expected a numeric segment", ErrInvalidFormat, pos)
+	}
+
+	if len(digits) > 1 && digits[0] == '0' {
+		return 0, pos, fmt.Errorf("%w at offset %d: numeric segment %q has a leading zero", ErrInvalidFormat, start, digits)
+	}
+
+	n, convErr := strconv.ParseUint(digits, 10, 64)
+	if convErr != nil {
+		return 0, pos, fmt.Errorf("%w at offset %d: %s", ErrInvalidFormat, start, convErr)
+	}
+
+	return n, pos, nil
+}
+
+// scanIdentifiers scans one or more dot-separated identifiers starting at
+// pos, stopping at the first byte that cannot belong to one (in a valid
+// version, '+' or the end of the string). Each identifier must be non-empty and drawn
+// from [0-9A-Za-z-]; when rejectLeadingZero is true, an all-digit
+// identifier longer than one character may not start with '0' (the
+// pre-release rule — build metadata has no such rule).
+func scanIdentifiers(s string, pos int, rejectLeadingZero bool) (int, error) {
+	for {
+		start := pos
+
+		for pos < len(s) && isIdentifierChar(s[pos]) {
+			pos++
+		}
+
+		id := s[start:pos]
+		if id == "" {
+			return pos, fmt.Errorf("%w at offset %d: empty identifier", ErrInvalidFormat, pos)
+		}
+
+		if rejectLeadingZero && len(id) > 1 && id[0] == '0' && isAllDigits(id) {
+			return pos, fmt.Errorf("%w at offset %d: numeric identifier %q has a leading zero", ErrInvalidFormat, start, id)
+		}
+
+		if pos >= len(s) || s[pos] != '.' {
+			return pos, nil
+		}
+
+		pos++
+	}
+}
+
+func isDigit(b byte) bool {
+	return b >= '0' && b <= '9'
+}
+
+func isIdentifierChar(b byte) bool {
+	return isDigit(b) || (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z') || b == '-'
+}
+
+func isAllDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			return false
+		}
+	}
+
+	return true
+}
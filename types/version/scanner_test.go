@@ -0,0 +1,30 @@
+package version_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Diaszano/gotterns/types/version"
+)
+
+func TestTryParse_ErrorHasPositionalContext(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "missing patch segment", input: "1.2"},
+		{name: "leading zero in minor", input: "1.02.0"},
+		{name: "leading zero in pre-release identifier", input: "1.0.0-01"},
+		{name: "empty pre-release identifier", input: "1.0.0-alpha..1"},
+		{name: "trailing garbage", input: "1.0.0 "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := version.TryParse(tt.input)
+			require.ErrorIs(t, err, version.ErrInvalidFormat)
+			require.Contains(t, err.Error(), "at offset")
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package version
+
+import "sort"
+
+// Versions is a slice of Version that implements sort.Interface,
+// ordering elements using Version.Compare.
+type Versions []Version
+
+// Len implements sort.Interface.
+func (vs Versions) Len() int {
+	return len(vs)
+}
+
+// Less implements sort.Interface.
+func (vs Versions) Less(i, j int) bool {
+	return vs[i].Compare(vs[j]) < 0
+}
+
+// Swap implements sort.Interface.
+func (vs Versions) Swap(i, j int) {
+	vs[i], vs[j] = vs[j], vs[i]
+}
+
+// Sort sorts vs in place in ascending order, using Version.Compare.
+func Sort(vs []Version) {
+	sort.Sort(Versions(vs))
+}
+
+// SortStrings parses each element of ss as a Version and returns them
+// sorted in ascending order.
+//
+// Returns the first parse error encountered, in which case the returned
+// slice is nil.
+func SortStrings(ss []string) ([]Version, error) {
+	vs := make([]Version, len(ss))
+
+	for i, s := range ss {
+		v, err := TryParse(s)
+		if err != nil {
+			return nil, err
+		}
+
+		vs[i] = v
+	}
+
+	Sort(vs)
+
+	return vs, nil
+}
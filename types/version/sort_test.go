@@ -0,0 +1,60 @@
+package version_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Diaszano/gotterns/types/version"
+)
+
+func TestVersions_SortInterface(t *testing.T) {
+	vs := version.Versions{
+		version.Version{Major: 1, Minor: 2, Patch: 0},
+		version.Version{Major: 1, Minor: 0, Patch: 0},
+		version.Version{Major: 2, Minor: 0, Patch: 0},
+	}
+
+	sort.Sort(vs)
+
+	require.Equal(t, version.Versions{
+		version.Version{Major: 1, Minor: 0, Patch: 0},
+		version.Version{Major: 1, Minor: 2, Patch: 0},
+		version.Version{Major: 2, Minor: 0, Patch: 0},
+	}, vs)
+}
+
+func TestSort(t *testing.T) {
+	vs := []version.Version{
+		{Major: 1, Minor: 0, Patch: 0, PreRelease: "beta"},
+		{Major: 1, Minor: 0, Patch: 0},
+		{Major: 1, Minor: 0, Patch: 0, PreRelease: "alpha"},
+	}
+
+	version.Sort(vs)
+
+	require.Equal(t, []version.Version{
+		{Major: 1, Minor: 0, Patch: 0, PreRelease: "alpha"},
+		{Major: 1, Minor: 0, Patch: 0, PreRelease: "beta"},
+		{Major: 1, Minor: 0, Patch: 0},
+	}, vs)
+}
+
+func TestSortStrings(t *testing.T) {
+	vs, err := version.SortStrings([]string{"1.2.0", "1.0.0", "v2.0.0", "1.0.0-alpha"})
+	require.NoError(t, err)
+
+	require.Equal(t, []version.Version{
+		{Major: 1, Minor: 0, Patch: 0, PreRelease: "alpha", PreReleaseIDs: []version.PreReleaseID{{Str: "alpha"}}},
+		{Major: 1, Minor: 0, Patch: 0},
+		{Major: 1, Minor: 2, Patch: 0},
+		{Major: 2, Minor: 0, Patch: 0},
+	}, vs)
+}
+
+func TestSortStrings_InvalidInput(t *testing.T) {
+	vs, err := version.SortStrings([]string{"1.0.0", "not-a-version"})
+	require.Error(t, err)
+	require.Nil(t, vs)
+}
@@ -10,23 +10,10 @@ package version
 import (
 	"errors"
 	"fmt"
-	"regexp"
 	"strconv"
 	"strings"
 )
 
-// re defines the regular expression pattern that matches
-// valid semantic version strings according to the SemVer 2.0.0 specification.
-//
-// Examples of valid versions:
-//   - 1.0.0
-//   - 1.0.0-alpha
-//   - 1.0.0-alpha+001
-//   - 1.0.0+build.123
-var re = regexp.MustCompile(
-	`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`,
-)
-
 // Common error variables for version validation.
 var (
 	// ErrBase is the base error used for all version-related errors.
@@ -43,12 +30,20 @@ var (
 // Version represents a semantic version (SemVer 2.0.0).
 // It includes the major, minor, and patch numbers,
 // as well as optional prerelease and build metadata fields.
+//
+// PreReleaseIDs and BuildIDs are the dotted identifiers of PreRelease and
+// Build parsed into slices by TryParse, for callers that need to inspect
+// or rebuild individual identifiers (see comparePreRelease and
+// PreReleaseID). They are derived from PreRelease/Build and are not
+// populated when a Version is built directly as a struct literal.
 type Version struct {
-	Major      uint64 `json:"major"`
-	Minor      uint64 `json:"minor"`
-	Patch      uint64 `json:"patch"`
-	PreRelease string `json:"pre_release,omitempty"`
-	Build      string `json:"build,omitempty"`
+	Major         uint64         `json:"major"`
+	Minor         uint64         `json:"minor"`
+	Patch         uint64         `json:"patch"`
+	PreRelease    string         `json:"pre_release,omitempty"`
+	Build         string         `json:"build,omitempty"`
+	PreReleaseIDs []PreReleaseID `json:"-"`
+	BuildIDs      []string       `json:"-"`
 }
 
 // String returns the semantic version as a formatted string
@@ -93,7 +88,9 @@ func (v Version) Version() string {
 //  1. Major
 //  2. Minor
 //  3. Patch
-//  4. PreRelease (pre-release versions are considered lower than normal versions)
+//  4. PreRelease (pre-release versions are considered lower than normal
+//     versions; when both sides have a pre-release, identifiers are compared
+//     per SemVer 2.0.0 §11.4 via comparePreRelease, not as plain strings)
 //
 // Note: Build metadata is ignored in comparisons.
 func (v Version) Compare(other Version) int8 {
@@ -130,22 +127,86 @@ func (v Version) Compare(other Version) int8 {
 	}
 
 	if v.PreRelease != "" && other.PreRelease != "" {
-		if v.PreRelease > other.PreRelease {
+		return comparePreRelease(v.PreRelease, other.PreRelease)
+	}
+
+	// Versions are equal (including pre-release)
+	return 0
+}
+
+// comparePreRelease compares two dot-separated pre-release strings
+// according to SemVer 2.0.0 §11.4.
+//
+// Identifiers consisting only of digits are compared numerically;
+// identifiers with letters or hyphens are compared lexically in ASCII
+// order; numeric identifiers always have lower precedence than
+// alphanumeric identifiers. A larger set of identifiers has higher
+// precedence than a smaller set when all preceding identifiers are equal.
+//
+// Returns 1 if a > b, -1 if a < b, 0 if a == b.
+func comparePreRelease(a, b string) int8 {
+	if a == b {
+		return 0
+	}
+
+	aIDs := strings.Split(a, ".")
+	bIDs := strings.Split(b, ".")
+
+	for i := 0; i < len(aIDs) || i < len(bIDs); i++ {
+		if i >= len(aIDs) {
+			return -1
+		}
+		if i >= len(bIDs) {
 			return 1
-		} else if v.PreRelease < other.PreRelease {
+		}
+
+		aID, bID := aIDs[i], bIDs[i]
+		if aID == bID {
+			continue
+		}
+
+		aNum, aIsNum := parseNumericIdentifier(aID)
+		bNum, bIsNum := parseNumericIdentifier(bID)
+
+		switch {
+		case aIsNum && bIsNum:
+			if aNum < bNum {
+				return -1
+			}
+			return 1
+		case aIsNum && !bIsNum:
+			return -1
+		case !aIsNum && bIsNum:
+			return 1
+		case aID < bID:
 			return -1
+		default:
+			return 1
 		}
 	}
 
-	// Versions are equal (including pre-release)
 	return 0
 }
 
+// parseNumericIdentifier reports whether id is a numeric pre-release
+// identifier (all-digits) and, if so, its value.
+func parseNumericIdentifier(id string) (uint64, bool) {
+	n, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
 // TryParse attempts to parse a semantic version string into a Version struct.
 //
 // It safely returns an error instead of panicking when the input is invalid.
 // The function supports both versions with and without a 'v' prefix.
 //
+// Parsing is a single left-to-right scan over the input (see scanVersion)
+// rather than a regular expression match, so it makes no allocations
+// beyond the returned Version's strings and slices.
+//
 // Examples:
 //
 //	version, err := TryParse("v1.2.3")
@@ -153,45 +214,28 @@ func (v Version) Compare(other Version) int8 {
 //
 // Possible errors:
 //   - ErrEmpty if the input string is empty
-//   - ErrInvalidFormat if the version does not conform to the SemVer specification
+//   - ErrInvalidFormat if the version does not conform to the SemVer specification,
+//     wrapped with the byte offset and reason of the first scanning failure
 func TryParse(input string) (Version, error) {
 	if input == "" {
 		return Version{}, ErrEmpty
 	}
 
-	version := strings.TrimPrefix(input, "v")
-
-	if !re.MatchString(version) {
-		return Version{}, ErrInvalidFormat
-	}
-
-	matches := re.FindStringSubmatch(version)
-
-	if len(matches) < 6 {
-		return Version{}, ErrInvalidFormat
-	}
-
-	major, err := strconv.ParseUint(matches[1], 10, 64)
-	if err != nil {
-		return Version{}, errors.Join(ErrBase, err)
-	}
-
-	minor, err := strconv.ParseUint(matches[2], 10, 64)
-	if err != nil {
-		return Version{}, errors.Join(ErrBase, err)
-	}
+	s := strings.TrimPrefix(input, "v")
 
-	patch, err := strconv.ParseUint(matches[3], 10, 64)
+	major, minor, patch, preRelease, build, err := scanVersion(s)
 	if err != nil {
-		return Version{}, errors.Join(ErrBase, err)
+		return Version{}, err
 	}
 
 	return Version{
-		Major:      major,
-		Minor:      minor,
-		Patch:      patch,
-		PreRelease: matches[4],
-		Build:      matches[5],
+		Major:         major,
+		Minor:         minor,
+		Patch:         patch,
+		PreRelease:    preRelease,
+		Build:         build,
+		PreReleaseIDs: parsePreReleaseIDs(preRelease),
+		BuildIDs:      parseBuildIDs(build),
 	}, nil
 }
 
@@ -39,10 +39,11 @@ func TestTryParse(t *testing.T) {
 			name:  "version with prerelease",
 			input: "1.2.3-alpha",
 			want: version.Version{
-				Major:      1,
-				Minor:      2,
-				Patch:      3,
-				PreRelease: "alpha",
+				Major:         1,
+				Minor:         2,
+				Patch:         3,
+				PreRelease:    "alpha",
+				PreReleaseIDs: []version.PreReleaseID{{Str: "alpha"}},
 			},
 			wantErr: nil,
 		},
@@ -50,10 +51,11 @@ func TestTryParse(t *testing.T) {
 			name:  "version with build metadata",
 			input: "1.2.3+build.123",
 			want: version.Version{
-				Major: 1,
-				Minor: 2,
-				Patch: 3,
-				Build: "build.123",
+				Major:    1,
+				Minor:    2,
+				Patch:    3,
+				Build:    "build.123",
+				BuildIDs: []string{"build", "123"},
 			},
 			wantErr: nil,
 		},
@@ -61,11 +63,13 @@ func TestTryParse(t *testing.T) {
 			name:  "version with prerelease and build metadata",
 			input: "1.2.3-beta+exp.sha.5114f85",
 			want: version.Version{
-				Major:      1,
-				Minor:      2,
-				Patch:      3,
-				PreRelease: "beta",
-				Build:      "exp.sha.5114f85",
+				Major:         1,
+				Minor:         2,
+				Patch:         3,
+				PreRelease:    "beta",
+				Build:         "exp.sha.5114f85",
+				PreReleaseIDs: []version.PreReleaseID{{Str: "beta"}},
+				BuildIDs:      []string{"exp", "sha", "5114f85"},
 			},
 			wantErr: nil,
 		},
@@ -133,31 +137,35 @@ func TestParse(t *testing.T) {
 			name:  "version with prerelease",
 			input: "1.2.3-alpha",
 			want: version.Version{
-				Major:      1,
-				Minor:      2,
-				Patch:      3,
-				PreRelease: "alpha",
+				Major:         1,
+				Minor:         2,
+				Patch:         3,
+				PreRelease:    "alpha",
+				PreReleaseIDs: []version.PreReleaseID{{Str: "alpha"}},
 			},
 		},
 		{
 			name:  "version with build metadata",
 			input: "1.2.3+build.123",
 			want: version.Version{
-				Major: 1,
-				Minor: 2,
-				Patch: 3,
-				Build: "build.123",
+				Major:    1,
+				Minor:    2,
+				Patch:    3,
+				Build:    "build.123",
+				BuildIDs: []string{"build", "123"},
 			},
 		},
 		{
 			name:  "version with prerelease and build metadata",
 			input: "1.2.3-beta+exp.sha.5114f85",
 			want: version.Version{
-				Major:      1,
-				Minor:      2,
-				Patch:      3,
-				PreRelease: "beta",
-				Build:      "exp.sha.5114f85",
+				Major:         1,
+				Minor:         2,
+				Patch:         3,
+				PreRelease:    "beta",
+				Build:         "exp.sha.5114f85",
+				PreReleaseIDs: []version.PreReleaseID{{Str: "beta"}},
+				BuildIDs:      []string{"exp", "sha", "5114f85"},
 			},
 		},
 		{
@@ -183,9 +191,16 @@ func TestParse(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			if tt.expectPanic {
-				require.PanicsWithError(t, tt.err.Error(), func() {
-					version.Parse(tt.input)
-				}, "expected panic for input %q", tt.input)
+				defer func() {
+					r := recover()
+					require.NotNil(t, r, "expected panic for input %q", tt.input)
+
+					err, ok := r.(error)
+					require.True(t, ok, "expected panic value to be an error for input %q", tt.input)
+					require.ErrorIs(t, err, tt.err)
+				}()
+
+				version.Parse(tt.input)
 				return
 			}
 
@@ -322,6 +337,60 @@ func TestVersion_Compare(t *testing.T) {
 			v2:       version.Version{Major: 1, Minor: 2, Patch: 3, Build: "002"},
 			expected: 0,
 		},
+		{
+			name:     "numeric identifiers compare numerically, not lexically",
+			v1:       version.Version{Major: 1, Minor: 0, Patch: 0, PreRelease: "alpha.2"},
+			v2:       version.Version{Major: 1, Minor: 0, Patch: 0, PreRelease: "alpha.11"},
+			expected: -1,
+		},
+		{
+			name:     "alphanumeric identifiers compare lexically, rc.1 greater than beta",
+			v1:       version.Version{Major: 1, Minor: 0, Patch: 0, PreRelease: "rc.1"},
+			v2:       version.Version{Major: 1, Minor: 0, Patch: 0, PreRelease: "beta"},
+			expected: 1,
+		},
+		{
+			name:     "alpha smaller than alpha.1",
+			v1:       version.Version{Major: 1, Minor: 0, Patch: 0, PreRelease: "alpha"},
+			v2:       version.Version{Major: 1, Minor: 0, Patch: 0, PreRelease: "alpha.1"},
+			expected: -1,
+		},
+		{
+			name:     "alpha.1 smaller than alpha.beta",
+			v1:       version.Version{Major: 1, Minor: 0, Patch: 0, PreRelease: "alpha.1"},
+			v2:       version.Version{Major: 1, Minor: 0, Patch: 0, PreRelease: "alpha.beta"},
+			expected: -1,
+		},
+		{
+			name:     "alpha.beta smaller than beta",
+			v1:       version.Version{Major: 1, Minor: 0, Patch: 0, PreRelease: "alpha.beta"},
+			v2:       version.Version{Major: 1, Minor: 0, Patch: 0, PreRelease: "beta"},
+			expected: -1,
+		},
+		{
+			name:     "beta smaller than beta.2",
+			v1:       version.Version{Major: 1, Minor: 0, Patch: 0, PreRelease: "beta"},
+			v2:       version.Version{Major: 1, Minor: 0, Patch: 0, PreRelease: "beta.2"},
+			expected: -1,
+		},
+		{
+			name:     "beta.2 smaller than beta.11",
+			v1:       version.Version{Major: 1, Minor: 0, Patch: 0, PreRelease: "beta.2"},
+			v2:       version.Version{Major: 1, Minor: 0, Patch: 0, PreRelease: "beta.11"},
+			expected: -1,
+		},
+		{
+			name:     "beta.11 smaller than rc.1",
+			v1:       version.Version{Major: 1, Minor: 0, Patch: 0, PreRelease: "beta.11"},
+			v2:       version.Version{Major: 1, Minor: 0, Patch: 0, PreRelease: "rc.1"},
+			expected: -1,
+		},
+		{
+			name:     "rc.1 smaller than release",
+			v1:       version.Version{Major: 1, Minor: 0, Patch: 0, PreRelease: "rc.1"},
+			v2:       version.Version{Major: 1, Minor: 0, Patch: 0},
+			expected: -1,
+		},
 	}
 
 	for _, tt := range tests {
@@ -331,3 +400,11 @@ func TestVersion_Compare(t *testing.T) {
 		})
 	}
 }
+
+func BenchmarkTryParse(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = version.TryParse("v1.2.3-alpha.1+build.001")
+	}
+}